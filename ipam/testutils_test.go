@@ -1,6 +1,7 @@
 package ipam
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"sync"
@@ -65,6 +66,11 @@ func (m *mockGossipComms) GossipBroadcast(update mesh.GossipData) {
 	}
 }
 
+func (m *mockGossipComms) GossipBroadcastContext(_ context.Context, update mesh.GossipData) error {
+	m.GossipBroadcast(update)
+	return nil
+}
+
 func equalByteBuffer(a, b []byte) bool {
 	if len(a) != len(b) {
 		return false
@@ -97,6 +103,10 @@ func (m *mockGossipComms) GossipUnicast(dstPeerName mesh.PeerName, buf []byte) e
 	return nil
 }
 
+func (m *mockGossipComms) GossipUnicastContext(_ context.Context, dstPeerName mesh.PeerName, buf []byte) error {
+	return m.GossipUnicast(dstPeerName, buf)
+}
+
 func ExpectMessage(alloc *Allocator, dst string, msgType byte, buf []byte) {
 	m := alloc.gossip.(*mockGossipComms)
 	dstPeerName, _ := mesh.PeerNameFromString(dst)
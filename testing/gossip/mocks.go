@@ -1,6 +1,7 @@
 package gossip
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"math/rand"
@@ -185,6 +186,13 @@ func (grouter *TestRouter) Connect(sender mesh.PeerName, gossiper mesh.Gossiper)
 }
 
 func (client TestRouterClient) GossipUnicast(dstPeerName mesh.PeerName, buf []byte) error {
+	return client.GossipUnicastContext(context.Background(), dstPeerName, buf)
+}
+
+func (client TestRouterClient) GossipUnicastContext(ctx context.Context, dstPeerName mesh.PeerName, buf []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	client.router.Lock()
 	gossipChan := client.router.gossipChans[dstPeerName]
 	client.router.Unlock()
@@ -199,3 +207,11 @@ func (client TestRouterClient) GossipUnicast(dstPeerName mesh.PeerName, buf []by
 func (client TestRouterClient) GossipBroadcast(update mesh.GossipData) {
 	client.router.gossipBroadcast(client.sender, update)
 }
+
+func (client TestRouterClient) GossipBroadcastContext(ctx context.Context, update mesh.GossipData) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	client.GossipBroadcast(update)
+	return nil
+}